@@ -0,0 +1,114 @@
+// Package cli wires HiMind's subcommands into a single urfave/cli
+// application. Subcommands register themselves via Register (typically
+// from an init() in internal/cli/commands), so adding a new command never
+// requires touching main or this package.
+package cli
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Command is the contract every HiMind subcommand implements.
+type Command interface {
+	// Name is the subcommand's invocation name, e.g. "search".
+	Name() string
+	// Usage is the one-line summary shown in `hi-mind help`.
+	Usage() string
+	// Flags declares the command's own `--flag` options.
+	Flags() []cli.Flag
+	// Run executes the command with its parsed flags/arguments.
+	Run(ctx context.Context, c *cli.Context) error
+}
+
+var (
+	registry    []Command
+	rawRegistry []*cli.Command
+)
+
+// Register adds a Command to the application. It is meant to be called
+// from subcommand packages' init() functions.
+func Register(cmd Command) {
+	registry = append(registry, cmd)
+}
+
+// RegisterRaw adds a pre-built urfave/cli Command directly, for the rare
+// subcommand family (e.g. `connect add/remove/default/list`) whose nested
+// structure doesn't fit the flat Command interface.
+func RegisterRaw(cmd *cli.Command) {
+	rawRegistry = append(rawRegistry, cmd)
+}
+
+// globalFlags are available to every subcommand, ahead of the subcommand
+// name, e.g. `hi-mind -c staging search ...`.
+func globalFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    "connection",
+			Aliases: []string{"c"},
+			Usage:   "named endpoint to use (see `hi-mind connect list`)",
+			EnvVars: []string{"HIMIND_CONNECTION"},
+		},
+		&cli.StringFlag{
+			Name:    "log-level",
+			Aliases: []string{"verbose"},
+			Usage:   "log level: debug, info, warn, error",
+			Value:   "info",
+			EnvVars: []string{"HIMIND_LOG_LEVEL"},
+		},
+	}
+}
+
+// parseLogLevel maps a --log-level value to its slog.Level.
+func parseLogLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return 0, fmt.Errorf("cli: unknown log level %q", s)
+	}
+}
+
+// NewApp builds the urfave/cli application from every registered Command.
+func NewApp() *cli.App {
+	app := &cli.App{
+		Name:                 "hi-mind",
+		Usage:                "search and ask HiMind's knowledge base",
+		EnableBashCompletion: true,
+		Flags:                globalFlags(),
+		Before: func(c *cli.Context) error {
+			level, err := parseLogLevel(c.String("log-level"))
+			if err != nil {
+				return err
+			}
+			slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+			return nil
+		},
+	}
+
+	for _, cmd := range registry {
+		cmd := cmd
+		app.Commands = append(app.Commands, &cli.Command{
+			Name:  cmd.Name(),
+			Usage: cmd.Usage(),
+			Flags: cmd.Flags(),
+			Action: func(c *cli.Context) error {
+				return cmd.Run(c.Context, c)
+			},
+		})
+	}
+	app.Commands = append(app.Commands, rawRegistry...)
+
+	return app
+}
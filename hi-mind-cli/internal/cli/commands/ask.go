@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v2"
+
+	himindcli "hi-mind-cli/internal/cli"
+)
+
+func init() {
+	himindcli.Register(askCommand{})
+}
+
+// askCommand is reserved for a future conversational endpoint; HiMind's
+// server does not expose one yet.
+type askCommand struct{}
+
+func (askCommand) Name() string      { return "ask" }
+func (askCommand) Usage() string     { return "ask HiMind a question (not yet implemented)" }
+func (askCommand) Flags() []cli.Flag { return nil }
+
+func (askCommand) Run(_ context.Context, _ *cli.Context) error {
+	return cli.Exit("ask: not yet implemented", 1)
+}
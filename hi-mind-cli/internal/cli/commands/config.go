@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	himindcli "hi-mind-cli/internal/cli"
+	"hi-mind-cli/internal/config"
+)
+
+func init() {
+	himindcli.Register(configCommand{})
+}
+
+// configCommand prints the resolved configuration. Endpoint management
+// lives under the `connect` subcommand family.
+type configCommand struct{}
+
+func (configCommand) Name() string      { return "config" }
+func (configCommand) Usage() string     { return "show the resolved HiMind configuration" }
+func (configCommand) Flags() []cli.Flag { return nil }
+
+func (configCommand) Run(_ context.Context, c *cli.Context) error {
+	cfg, err := config.Parse()
+	if err != nil {
+		return fmt.Errorf("parsing config: %w", err)
+	}
+
+	endpoint, err := cfg.Resolve(c.String("connection"))
+	if err != nil {
+		return fmt.Errorf("resolving endpoint: %w", err)
+	}
+
+	fmt.Printf("endpoint: %s\n", endpoint.URI())
+	return nil
+}
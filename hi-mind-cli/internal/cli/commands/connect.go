@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/urfave/cli/v2"
+
+	himindcli "hi-mind-cli/internal/cli"
+	"hi-mind-cli/internal/config"
+)
+
+// connect manages the named endpoints in the HiMind config file. It is
+// registered raw rather than through the flat Command interface because
+// its add/remove/default/list subcommands don't fit a single Run method.
+func init() {
+	himindcli.RegisterRaw(&cli.Command{
+		Name:  "connect",
+		Usage: "manage named HiMind endpoints",
+		Subcommands: []*cli.Command{
+			connectAddCommand(),
+			connectRemoveCommand(),
+			connectDefaultCommand(),
+			connectListCommand(),
+		},
+	})
+}
+
+func connectAddCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "add",
+		Usage:     "add or update a named endpoint",
+		ArgsUsage: "NAME",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "host", Required: true},
+			&cli.StringFlag{Name: "port", Required: true},
+		},
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if name == "" {
+				return cli.Exit("connect add requires a NAME", 1)
+			}
+
+			path, cfg, err := loadConfigForWrite()
+			if err != nil {
+				return err
+			}
+
+			cfg.Endpoints[name] = config.Endpoint{
+				Host: c.String("host"),
+				Port: c.String("port"),
+			}
+			if cfg.Default == "" {
+				cfg.Default = name
+			}
+
+			if err := cfg.Save(path); err != nil {
+				return err
+			}
+
+			fmt.Printf("added endpoint %q\n", name)
+			return nil
+		},
+	}
+}
+
+func connectRemoveCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "remove",
+		Usage:     "remove a named endpoint",
+		ArgsUsage: "NAME",
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if name == "" {
+				return cli.Exit("connect remove requires a NAME", 1)
+			}
+
+			path, cfg, err := loadConfigForWrite()
+			if err != nil {
+				return err
+			}
+
+			if _, ok := cfg.Endpoints[name]; !ok {
+				return fmt.Errorf("connect remove: %w: %q", config.ErrUnknownEndpoint, name)
+			}
+			delete(cfg.Endpoints, name)
+			if cfg.Default == name {
+				cfg.Default = ""
+			}
+
+			if err := cfg.Save(path); err != nil {
+				return err
+			}
+
+			fmt.Printf("removed endpoint %q\n", name)
+			return nil
+		},
+	}
+}
+
+func connectDefaultCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "default",
+		Usage:     "set the default endpoint",
+		ArgsUsage: "NAME",
+		Action: func(c *cli.Context) error {
+			name := c.Args().First()
+			if name == "" {
+				return cli.Exit("connect default requires a NAME", 1)
+			}
+
+			path, cfg, err := loadConfigForWrite()
+			if err != nil {
+				return err
+			}
+
+			if _, ok := cfg.Endpoints[name]; !ok {
+				return fmt.Errorf("connect default: %w: %q", config.ErrUnknownEndpoint, name)
+			}
+			cfg.Default = name
+
+			if err := cfg.Save(path); err != nil {
+				return err
+			}
+
+			fmt.Printf("default endpoint is now %q\n", name)
+			return nil
+		},
+	}
+}
+
+func connectListCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "list configured endpoints",
+		Action: func(c *cli.Context) error {
+			cfg, err := config.Parse()
+			if err != nil {
+				return fmt.Errorf("parsing config: %w", err)
+			}
+
+			names := make([]string, 0, len(cfg.Endpoints))
+			for name := range cfg.Endpoints {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			for _, name := range names {
+				marker := " "
+				if name == cfg.Default {
+					marker = "*"
+				}
+				ep := cfg.Endpoints[name]
+				fmt.Printf("%s %s\t%s\n", marker, name, ep.URI())
+			}
+			return nil
+		},
+	}
+}
+
+// loadConfigForWrite resolves the config path and parses the existing
+// config if present, returning an empty Application only when no
+// config file exists yet, so that `connect add` works against a
+// brand-new config file. Any other error (corrupt YAML, permissions,
+// ...) is propagated so callers don't overwrite a config they failed to
+// read correctly.
+func loadConfigForWrite() (string, *config.Application, error) {
+	path, err := config.Path()
+	if err != nil {
+		return "", nil, err
+	}
+
+	cfg, err := config.Parse()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return path, &config.Application{Endpoints: map[string]config.Endpoint{}}, nil
+		}
+		return "", nil, fmt.Errorf("connect: reading existing config: %w", err)
+	}
+
+	return path, cfg, nil
+}
@@ -0,0 +1,25 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v2"
+
+	himindcli "hi-mind-cli/internal/cli"
+)
+
+func init() {
+	himindcli.Register(indexCommand{})
+}
+
+// indexCommand is reserved for triggering a re-index of HiMind's knowledge
+// base; the server does not expose that endpoint yet.
+type indexCommand struct{}
+
+func (indexCommand) Name() string      { return "index" }
+func (indexCommand) Usage() string     { return "trigger a HiMind re-index (not yet implemented)" }
+func (indexCommand) Flags() []cli.Flag { return nil }
+
+func (indexCommand) Run(_ context.Context, _ *cli.Context) error {
+	return cli.Exit("index: not yet implemented", 1)
+}
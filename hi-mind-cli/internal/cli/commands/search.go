@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	himindcli "hi-mind-cli/internal/cli"
+	"hi-mind-cli/internal/config"
+	"hi-mind-cli/internal/httpclient"
+	"hi-mind-cli/internal/output"
+	"hi-mind-cli/internal/stream"
+)
+
+func init() {
+	himindcli.Register(searchCommand{})
+}
+
+type searchCommand struct{}
+
+func (searchCommand) Name() string  { return "search" }
+func (searchCommand) Usage() string { return "search for knowledge in HiMind" }
+
+func (searchCommand) Flags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:    "no-experts",
+			Aliases: []string{"n"},
+			Usage:   "omit the expert output",
+		},
+		&cli.DurationFlag{
+			Name:  "timeout",
+			Usage: "abort the search, including a stream still in progress, once this duration has elapsed",
+			Value: 30 * time.Second,
+		},
+		&cli.StringFlag{
+			Name:    "output",
+			Aliases: []string{"o"},
+			Usage:   "output format: plain, json, yaml, table, markdown, template",
+			Value:   "plain",
+		},
+		&cli.StringFlag{
+			Name:  "template",
+			Usage: "Go text/template string, used with -o template",
+		},
+	}
+}
+
+func (searchCommand) Run(ctx context.Context, c *cli.Context) error {
+	if c.NArg() == 0 {
+		return cli.Exit("search requires a query, e.g. `hi-mind search how do I deploy`", 1)
+	}
+
+	formatterName := c.String("output")
+	formatter, err := resolveFormatter(formatterName, c.String("template"))
+	if err != nil {
+		return err
+	}
+
+	query := strings.Join(c.Args().Slice(), " ") + "?"
+
+	cfg, err := config.Parse()
+	if err != nil {
+		return fmt.Errorf("parsing config: %w", err)
+	}
+
+	endpoint, err := cfg.Resolve(c.String("connection"))
+	if err != nil {
+		return fmt.Errorf("resolving endpoint: %w", err)
+	}
+
+	reqURL := fmt.Sprintf("http://%s/search?q=%s", endpoint.URI(), url.QueryEscape(query))
+	if c.Bool("no-experts") {
+		reqURL += "&no_experts=true"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.Duration("timeout"))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("building request: %w", err)
+	}
+	req.Header.Set("Accept", stream.ContentTypeNDJSON+", application/json")
+
+	client := httpclient.New(httpclient.CorrelationID(), httpclient.Logging(slog.Default()))
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("endpoint returned error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	ndjson := strings.HasPrefix(resp.Header.Get("Content-Type"), stream.ContentTypeNDJSON)
+	dec := stream.NewDecoder(resp.Body, ndjson)
+
+	// Plain output prints each result as it arrives, preserving the
+	// streaming UX; every other formatter is given all results in one
+	// call, since json, yaml and table each render a single document.
+	streamingOutput := formatterName == "plain"
+
+	var results []output.Result
+	printed := 0
+	for {
+		var v output.Result
+		ok, err := dec.Next(&v)
+		if !ok {
+			if err != nil && !errors.Is(err, context.Canceled) {
+				if printed == 0 {
+					return fmt.Errorf("decoding response: %w", err)
+				}
+				slog.WarnContext(ctx, "search aborted mid-stream", "printed", printed, "err", err)
+			}
+			break
+		}
+
+		if streamingOutput {
+			if err := formatter.Format(os.Stdout, []output.Result{v}); err != nil {
+				return fmt.Errorf("formatting result: %w", err)
+			}
+		} else {
+			results = append(results, v)
+		}
+		printed++
+	}
+
+	if !streamingOutput {
+		if err := formatter.Format(os.Stdout, results); err != nil {
+			return fmt.Errorf("formatting results: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func resolveFormatter(name, templateText string) (output.Formatter, error) {
+	if name == "template" {
+		if templateText == "" {
+			return nil, cli.Exit("--template is required when -o template is used", 1)
+		}
+		return output.NewTemplateFormatter(templateText)
+	}
+
+	formatter, ok := output.Get(name)
+	if !ok {
+		return nil, cli.Exit(fmt.Sprintf("unknown output format %q", name), 1)
+	}
+	return formatter, nil
+}
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	himindcli "hi-mind-cli/internal/cli"
+)
+
+// version is set at build time via -ldflags "-X ...commands.version=...".
+var version = "dev"
+
+func init() {
+	himindcli.Register(versionCommand{})
+}
+
+type versionCommand struct{}
+
+func (versionCommand) Name() string      { return "version" }
+func (versionCommand) Usage() string     { return "print the hi-mind CLI version" }
+func (versionCommand) Flags() []cli.Flag { return nil }
+
+func (versionCommand) Run(_ context.Context, _ *cli.Context) error {
+	fmt.Println("hi-mind " + version)
+	return nil
+}
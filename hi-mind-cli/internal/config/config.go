@@ -1,15 +1,20 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 
 	"gopkg.in/yaml.v3"
 )
 
+// Application is the root of HiMind's YAML config. It holds every known
+// endpoint plus which one is used when no `--connection` is given.
 type Application struct {
-	Endpoint Endpoint `yaml:"endpoint"`
+	Endpoints map[string]Endpoint `yaml:"endpoints"`
+	Default   string              `yaml:"default"`
 }
 
 type Endpoint struct {
@@ -21,20 +26,101 @@ func (x Endpoint) URI() string {
 	return fmt.Sprintf("%s:%s", x.Host, x.Port)
 }
 
+// ErrUnknownEndpoint is returned by Resolve when the requested connection
+// name has no matching entry in Endpoints.
+var ErrUnknownEndpoint = errors.New("config: unknown endpoint")
+
+// Resolve returns the endpoint named by `name`. An empty name falls back
+// to the config's Default, and then to the only endpoint if exactly one
+// is defined.
+func (a *Application) Resolve(name string) (Endpoint, error) {
+	if name == "" {
+		name = a.Default
+	}
+	if name == "" && len(a.Endpoints) == 1 {
+		for _, ep := range a.Endpoints {
+			return ep, nil
+		}
+	}
+
+	ep, ok := a.Endpoints[name]
+	if !ok {
+		return Endpoint{}, fmt.Errorf("%w: %q", ErrUnknownEndpoint, name)
+	}
+	return ep, nil
+}
+
+// Path resolves the config file location: $HIMIND_CONFIG if set, else
+// ./config.yaml if it exists, else $XDG_CONFIG_HOME/himind/config.yaml if
+// XDG_CONFIG_HOME is set, else $HOME/.config/himind/config.yaml. Only
+// ./config.yaml is checked for existence; the other paths are returned
+// as they are.
+func Path() (string, error) {
+	if p := os.Getenv("HIMIND_CONFIG"); p != "" {
+		return p, nil
+	}
+
+	if _, err := os.Stat("config.yaml"); err == nil {
+		return "config.yaml", nil
+	}
+
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, "himind", "config.yaml"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("config: resolving home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "himind", "config.yaml"), nil
+}
+
+// Parse locates and decodes the config file (see Path).
 func Parse() (*Application, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
 	var cfg Application
 
-	f, err := os.Open("config.yaml")
+	f, err := os.Open(path)
 	if err != nil {
-		slog.Error("config: opening config file", "err", err)
+		slog.Error("config: opening config file", "path", path, "err", err)
 		return nil, err
 	}
 	defer f.Close()
 
 	if err = yaml.NewDecoder(f).Decode(&cfg); err != nil {
-		slog.Error("config: decoding config file", "err", err)
+		slog.Error("config: decoding config file", "path", path, "err", err)
 		return nil, err
 	}
 
+	if cfg.Endpoints == nil {
+		cfg.Endpoints = map[string]Endpoint{}
+	}
+
 	return &cfg, nil
 }
+
+// Save writes the config back to path, creating parent directories as
+// needed.
+func (a *Application) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("config: creating config directory: %w", err)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("config: creating config file: %w", err)
+	}
+	defer f.Close()
+
+	enc := yaml.NewEncoder(f)
+	defer enc.Close()
+	if err := enc.Encode(a); err != nil {
+		return fmt.Errorf("config: encoding config file: %w", err)
+	}
+
+	return nil
+}
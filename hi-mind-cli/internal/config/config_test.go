@@ -0,0 +1,68 @@
+package config
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApplicationResolve(t *testing.T) {
+	prod := Endpoint{Host: "prod.himind.dev", Port: "443"}
+	staging := Endpoint{Host: "staging.himind.dev", Port: "443"}
+
+	tests := []struct {
+		name    string
+		app     Application
+		request string
+		want    Endpoint
+		wantErr error
+	}{
+		{
+			name:    "explicit name",
+			app:     Application{Endpoints: map[string]Endpoint{"prod": prod, "staging": staging}, Default: "prod"},
+			request: "staging",
+			want:    staging,
+		},
+		{
+			name:    "falls back to default",
+			app:     Application{Endpoints: map[string]Endpoint{"prod": prod, "staging": staging}, Default: "prod"},
+			request: "",
+			want:    prod,
+		},
+		{
+			name:    "falls back to the only endpoint",
+			app:     Application{Endpoints: map[string]Endpoint{"prod": prod}},
+			request: "",
+			want:    prod,
+		},
+		{
+			name:    "unknown name",
+			app:     Application{Endpoints: map[string]Endpoint{"prod": prod}},
+			request: "staging",
+			wantErr: ErrUnknownEndpoint,
+		},
+		{
+			name:    "no default with multiple endpoints",
+			app:     Application{Endpoints: map[string]Endpoint{"prod": prod, "staging": staging}},
+			request: "",
+			wantErr: ErrUnknownEndpoint,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.app.Resolve(tt.request)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Resolve() err = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Resolve() unexpected err: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Resolve() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
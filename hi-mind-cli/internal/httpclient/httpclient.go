@@ -0,0 +1,136 @@
+// Package httpclient builds the *http.Client the CLI uses to talk to a
+// HiMind endpoint. It layers a small RoundTripper middleware chain on top
+// of the transport so every request carries a correlation id and gets
+// logged consistently, without every call site having to remember to do
+// so itself.
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HeaderReferenceID is the header used to correlate a CLI request with the
+// server-side logs for it. If the server echoes its own value back, that
+// value is preserved; otherwise the client's generated id is used.
+const HeaderReferenceID = "X-Reference-Id"
+
+// RoundTripFunc adapts a function to http.RoundTripper.
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Interceptor wraps a RoundTripper with additional behavior. Interceptors
+// compose in the order passed to New/Chain: the first interceptor sees the
+// request first and the response last.
+type Interceptor func(next http.RoundTripper) http.RoundTripper
+
+// Chain wraps base with interceptors, applied outermost-first.
+func Chain(base http.RoundTripper, interceptors ...Interceptor) http.RoundTripper {
+	rt := base
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		rt = interceptors[i](rt)
+	}
+	return rt
+}
+
+// New builds an *http.Client whose transport runs every request through
+// interceptors, in order. With no interceptors it behaves like
+// http.DefaultClient.
+func New(interceptors ...Interceptor) *http.Client {
+	return &http.Client{Transport: Chain(http.DefaultTransport, interceptors...)}
+}
+
+// CorrelationID generates a UUID per request and sends it as
+// X-Reference-Id, so a failure in the CLI can be matched against the
+// corresponding entry in the server's logs.
+func CorrelationID() Interceptor {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			id := req.Header.Get(HeaderReferenceID)
+			if id == "" {
+				id = uuid.NewString()
+				req.Header.Set(HeaderReferenceID, id)
+			}
+
+			resp, err := next.RoundTrip(req)
+			if err == nil && resp.Header.Get(HeaderReferenceID) == "" {
+				resp.Header.Set(HeaderReferenceID, id)
+			}
+			return resp, err
+		})
+	}
+}
+
+// Logging logs method, URL, status, duration and byte counts at info
+// level, and full request/response bodies at debug level, all tagged with
+// the request's correlation id.
+func Logging(logger *slog.Logger) Interceptor {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			refID := req.Header.Get(HeaderReferenceID)
+
+			if logger.Enabled(ctx, slog.LevelDebug) {
+				logRequestBody(ctx, logger, refID, req)
+			}
+
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			duration := time.Since(start)
+
+			if err != nil {
+				logger.ErrorContext(ctx, "http request failed",
+					"ref_id", refID, "method", req.Method, "url", req.URL.String(),
+					"duration", duration, "err", err)
+				return resp, err
+			}
+
+			bodyLen := resp.ContentLength
+			if logger.Enabled(ctx, slog.LevelDebug) {
+				bodyLen = logResponseBody(ctx, logger, refID, resp)
+			}
+
+			logger.InfoContext(ctx, "http request",
+				"ref_id", refID, "method", req.Method, "url", req.URL.String(),
+				"status", resp.StatusCode, "duration", duration, "bytes", bodyLen)
+
+			return resp, nil
+		})
+	}
+}
+
+func logRequestBody(ctx context.Context, logger *slog.Logger, refID string, req *http.Request) {
+	if req.Body == nil {
+		return
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	logger.DebugContext(ctx, "http request body", "ref_id", refID, "body", string(b))
+}
+
+func logResponseBody(ctx context.Context, logger *slog.Logger, refID string, resp *http.Response) int64 {
+	if resp.Body == nil {
+		return 0
+	}
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return 0
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	logger.DebugContext(ctx, "http response body", "ref_id", refID, "body", string(b))
+	return int64(len(b))
+}
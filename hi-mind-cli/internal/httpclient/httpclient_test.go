@@ -0,0 +1,87 @@
+package httpclient
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func fakeTransport(statusCode int, body string, header http.Header) RoundTripFunc {
+	if header == nil {
+		header = http.Header{}
+	}
+	return func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	}
+}
+
+func TestCorrelationIDGeneratesID(t *testing.T) {
+	rt := CorrelationID()(fakeTransport(http.StatusOK, "", nil))
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+
+	id := req.Header.Get(HeaderReferenceID)
+	if id == "" {
+		t.Fatalf("expected a generated %s request header", HeaderReferenceID)
+	}
+	if resp.Header.Get(HeaderReferenceID) != id {
+		t.Fatalf("expected the response to be tagged with the request's id %q, got %q", id, resp.Header.Get(HeaderReferenceID))
+	}
+}
+
+func TestCorrelationIDPreservesServerValue(t *testing.T) {
+	rt := CorrelationID()(fakeTransport(http.StatusOK, "", http.Header{HeaderReferenceID: []string{"server-id"}}))
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+
+	if resp.Header.Get(HeaderReferenceID) != "server-id" {
+		t.Fatalf("expected the server's own reference id to be kept, got %q", resp.Header.Get(HeaderReferenceID))
+	}
+}
+
+func TestLoggingBodiesGatedByLevel(t *testing.T) {
+	var buf bytes.Buffer
+	level := &slog.LevelVar{}
+	level.Set(slog.LevelInfo)
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: level}))
+
+	rt := Logging(logger)(fakeTransport(http.StatusOK, "response-body", nil))
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", strings.NewReader("request-body"))
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if strings.Contains(buf.String(), "response-body") {
+		t.Fatalf("did not expect the response body to be logged at info level, got: %s", buf.String())
+	}
+
+	buf.Reset()
+	level.Set(slog.LevelDebug)
+
+	req = httptest.NewRequest(http.MethodGet, "http://example.com", strings.NewReader("request-body"))
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if !strings.Contains(buf.String(), "response-body") {
+		t.Fatalf("expected the response body to be logged at debug level, got: %s", buf.String())
+	}
+	if !strings.Contains(buf.String(), "request-body") {
+		t.Fatalf("expected the request body to be logged at debug level, got: %s", buf.String())
+	}
+}
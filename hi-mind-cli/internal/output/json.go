@@ -0,0 +1,18 @@
+package output
+
+import (
+	"encoding/json"
+	"io"
+)
+
+func init() {
+	Register("json", jsonFormatter{})
+}
+
+type jsonFormatter struct{}
+
+func (jsonFormatter) Format(w io.Writer, results []Result) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(results)
+}
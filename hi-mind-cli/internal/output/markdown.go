@@ -0,0 +1,20 @@
+package output
+
+import (
+	"fmt"
+	"io"
+)
+
+func init() {
+	Register("markdown", markdownFormatter{})
+}
+
+// markdownFormatter renders each result as a link-formatted blockquote.
+type markdownFormatter struct{}
+
+func (markdownFormatter) Format(w io.Writer, results []Result) error {
+	for _, r := range results {
+		fmt.Fprintf(w, "> %s\n>\n> — [%s](%s)\n\n", r.Content, r.Author, r.Link)
+	}
+	return nil
+}
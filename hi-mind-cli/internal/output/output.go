@@ -0,0 +1,31 @@
+// Package output turns search results into CLI-displayable text. It
+// defines a small Formatter extension point so new output formats can be
+// added without touching the search command.
+package output
+
+import "io"
+
+// Result is a single piece of knowledge returned by the HiMind search
+// endpoint.
+type Result struct {
+	Author, Content, Link string
+}
+
+// Formatter renders a set of results to w.
+type Formatter interface {
+	Format(w io.Writer, results []Result) error
+}
+
+var registry = map[string]Formatter{}
+
+// Register adds a Formatter under name, for later lookup via Get. It is
+// meant to be called from this package's own init() functions.
+func Register(name string, f Formatter) {
+	registry[name] = f
+}
+
+// Get looks up a registered Formatter by name.
+func Get(name string) (Formatter, bool) {
+	f, ok := registry[name]
+	return f, ok
+}
@@ -0,0 +1,24 @@
+package output
+
+import (
+	"fmt"
+	"io"
+)
+
+func init() {
+	Register("plain", plainFormatter{})
+}
+
+// plainFormatter reproduces the CLI's original bare-bones output: author,
+// content and link, one result per blank-line-separated block.
+type plainFormatter struct{}
+
+func (plainFormatter) Format(w io.Writer, results []Result) error {
+	for _, r := range results {
+		fmt.Fprintln(w, r.Author)
+		fmt.Fprintln(w, r.Content)
+		fmt.Fprintln(w, r.Link)
+		fmt.Fprintln(w)
+	}
+	return nil
+}
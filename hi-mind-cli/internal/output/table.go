@@ -0,0 +1,44 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+func init() {
+	Register("table", tableFormatter{})
+}
+
+// tableFormatter renders aligned columns, truncating content so each
+// result stays on one line.
+type tableFormatter struct{}
+
+const tableContentWidth = 60
+
+func (tableFormatter) Format(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "AUTHOR\tLINK\tCONTENT")
+	for _, r := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Author, r.Link, truncate(r.Content, tableContentWidth))
+	}
+
+	return tw.Flush()
+}
+
+// truncate collapses embedded newlines (so a multi-line Content can't
+// split a row across physical lines and misalign the tabwriter's columns)
+// and then clips to n runes.
+func truncate(s string, n int) string {
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	s = strings.ReplaceAll(s, "\n", " ")
+	s = strings.ReplaceAll(s, "\r", " ")
+
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,28 @@
+package output
+
+import "testing"
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		n    int
+		want string
+	}{
+		{name: "shorter than limit", in: "hello", n: 10, want: "hello"},
+		{name: "exact length", in: "hello", n: 5, want: "hello"},
+		{name: "ascii truncation", in: "hello world", n: 8, want: "hello w…"},
+		{name: "multi-byte runes", in: "héllo wörld", n: 8, want: "héllo w…"},
+		{name: "embedded newline below limit", in: "hello\nworld", n: 20, want: "hello world"},
+		{name: "embedded newline truncated", in: "hello\nworld wide web", n: 11, want: "hello worl…"},
+		{name: "crlf", in: "hello\r\nworld", n: 20, want: "hello world"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncate(tt.in, tt.n); got != tt.want {
+				t.Fatalf("truncate(%q, %d) = %q, want %q", tt.in, tt.n, got, tt.want)
+			}
+		})
+	}
+}
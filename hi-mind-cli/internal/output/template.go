@@ -0,0 +1,34 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+)
+
+// TemplateFormatter renders each Result through a user-supplied Go
+// text/template string. Unlike the other formatters it isn't registered
+// under a fixed name, since it needs the template text at construction
+// time; the search command builds one directly from its --template flag.
+type TemplateFormatter struct {
+	tmpl *template.Template
+}
+
+// NewTemplateFormatter parses text as a template executed once per
+// Result.
+func NewTemplateFormatter(text string) (*TemplateFormatter, error) {
+	tmpl, err := template.New("result").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("output: parsing template: %w", err)
+	}
+	return &TemplateFormatter{tmpl: tmpl}, nil
+}
+
+func (f *TemplateFormatter) Format(w io.Writer, results []Result) error {
+	for _, r := range results {
+		if err := f.tmpl.Execute(w, r); err != nil {
+			return fmt.Errorf("output: executing template: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTemplateFormatter(t *testing.T) {
+	f, err := NewTemplateFormatter("{{.Author}}: {{.Content}}\n")
+	if err != nil {
+		t.Fatalf("NewTemplateFormatter: %v", err)
+	}
+
+	var buf bytes.Buffer
+	results := []Result{{Author: "alice", Content: "hello"}, {Author: "bob", Content: "world"}}
+	if err := f.Format(&buf, results); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+
+	want := "alice: hello\nbob: world\n"
+	if buf.String() != want {
+		t.Fatalf("Format() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestTemplateFormatterParseError(t *testing.T) {
+	if _, err := NewTemplateFormatter("{{.Author"); err == nil {
+		t.Fatalf("expected a parse error for malformed template syntax")
+	}
+}
+
+func TestTemplateFormatterExecError(t *testing.T) {
+	f, err := NewTemplateFormatter("{{.NoSuchField}}")
+	if err != nil {
+		t.Fatalf("NewTemplateFormatter: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := f.Format(&buf, []Result{{Author: "alice"}}); err == nil {
+		t.Fatalf("expected an execution error referencing an unknown field")
+	}
+}
@@ -0,0 +1,19 @@
+package output
+
+import (
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+func init() {
+	Register("yaml", yamlFormatter{})
+}
+
+type yamlFormatter struct{}
+
+func (yamlFormatter) Format(w io.Writer, results []Result) error {
+	enc := yaml.NewEncoder(w)
+	defer enc.Close()
+	return enc.Encode(results)
+}
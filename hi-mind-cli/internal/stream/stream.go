@@ -0,0 +1,56 @@
+// Package stream decodes a sequence of JSON values from an HTTP response
+// body one at a time, whether the server sent them as NDJSON (one object
+// per line) or as a single JSON array. Callers get results as they arrive
+// instead of waiting for the whole body to be read.
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// ContentTypeNDJSON is the Accept/Content-Type value negotiated for
+// streaming responses.
+const ContentTypeNDJSON = "application/x-ndjson"
+
+// Decoder yields one JSON value at a time from a reader, transparently
+// handling both an NDJSON stream and a single top-level JSON array.
+type Decoder struct {
+	dec    *json.Decoder
+	ndjson bool
+	opened bool
+}
+
+// NewDecoder wraps r. ndjson selects the framing: true for one JSON value
+// per line, false for a single `[...]` array.
+func NewDecoder(r io.Reader, ndjson bool) *Decoder {
+	return &Decoder{dec: json.NewDecoder(r), ndjson: ndjson}
+}
+
+// Next decodes the next value into v. It returns false, nil once the
+// stream is exhausted.
+func (d *Decoder) Next(v any) (bool, error) {
+	if !d.ndjson {
+		if !d.opened {
+			if _, err := d.dec.Token(); err != nil { // consume the opening '['
+				if errors.Is(err, io.EOF) {
+					return false, nil
+				}
+				return false, err
+			}
+			d.opened = true
+		}
+		if !d.dec.More() {
+			return false, nil
+		}
+	}
+
+	if err := d.dec.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,92 @@
+package stream
+
+import (
+	"strings"
+	"testing"
+)
+
+type item struct {
+	Name string `json:"name"`
+}
+
+func TestDecoderNDJSON(t *testing.T) {
+	r := strings.NewReader("{\"name\":\"a\"}\n{\"name\":\"b\"}\n")
+	dec := NewDecoder(r, true)
+
+	var got []string
+	for {
+		var v item
+		ok, err := dec.Next(&v)
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		if !ok {
+			break
+		}
+		got = append(got, v.Name)
+	}
+
+	want := []string{"a", "b"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestDecoderArray(t *testing.T) {
+	r := strings.NewReader(`[{"name":"a"},{"name":"b"}]`)
+	dec := NewDecoder(r, false)
+
+	var got []string
+	for {
+		var v item
+		ok, err := dec.Next(&v)
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		if !ok {
+			break
+		}
+		got = append(got, v.Name)
+	}
+
+	want := []string{"a", "b"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestDecoderEmptyArray(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`[]`), false)
+
+	var v item
+	ok, err := dec.Next(&v)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected no results from an empty array")
+	}
+}
+
+func TestDecoderEmptyBody(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(""), true)
+
+	var v item
+	ok, err := dec.Next(&v)
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected no results from an empty body")
+	}
+}
+
+func TestDecoderMalformed(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`not json`), true)
+
+	var v item
+	_, err := dec.Next(&v)
+	if err == nil {
+		t.Fatalf("expected an error decoding malformed JSON")
+	}
+}